@@ -7,9 +7,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/internal/user"
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/pkg/httpx"
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/pkg/jwtx"
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/pkg/mathx"
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/pkg/metrics"
 )
 
 // ============================================================================
@@ -49,16 +57,6 @@ func divideWithRemainder(a, b int) (quotient int, remainder int) {
 // STRUCTS AND METHODS
 // ============================================================================
 
-type Person struct {
-	Name string
-	Age  int
-}
-
-var person = Person{
-	Name: "John",
-	Age:  30,
-}
-
 type Animal struct {
 	Name string
 }
@@ -218,26 +216,6 @@ const (
 	Execute          // 1 << 2 = 4
 )
 
-// Custom error type
-type ValidationError struct {
-	Field   string
-	Message string
-}
-
-func (e *ValidationError) Error() string {
-	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Message)
-}
-
-func validateAge(age int) error {
-	if age < 0 {
-		return &ValidationError{Field: "age", Message: "cannot be negative"}
-	}
-	if age > 150 {
-		return &ValidationError{Field: "age", Message: "unrealistic value"}
-	}
-	return nil
-}
-
 // Variadic functions
 func sum(numbers ...int) int {
 	total := 0
@@ -302,17 +280,9 @@ type Car struct {
 	Model  string
 }
 
-// Struct tags (for JSON, validation, etc.)
-type User struct {
-	ID       int    `json:"id"`
-	Username string `json:"username"`
-	Email    string `json:"email,omitempty"`
-	Password string `json:"-"` // Never serialize
-}
-
 // JSON encoding/decoding
 func demonstrateJSON() {
-	user := User{
+	u := user.User{
 		ID:       1,
 		Username: "johndoe",
 		Email:    "john@example.com",
@@ -320,7 +290,7 @@ func demonstrateJSON() {
 	}
 
 	// Marshal to JSON
-	jsonData, err := json.Marshal(user)
+	jsonData, err := json.Marshal(u)
 	if err != nil {
 		fmt.Println("Error marshaling:", err)
 		return
@@ -328,7 +298,7 @@ func demonstrateJSON() {
 	fmt.Println("JSON:", string(jsonData))
 
 	// Unmarshal from JSON
-	var decoded User
+	var decoded user.User
 	err = json.Unmarshal(jsonData, &decoded)
 	if err != nil {
 		fmt.Println("Error unmarshaling:", err)
@@ -337,6 +307,110 @@ func demonstrateJSON() {
 	fmt.Printf("Decoded: %+v\n", decoded)
 }
 
+// HTTP server with a logging/recovery/gzip middleware chain
+func demonstrateHTTP() {
+	srv, err := httpx.NewServer("127.0.0.1:0")
+	if err != nil {
+		fmt.Println("HTTP server error:", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- srv.Run(ctx, 2*time.Second)
+	}()
+
+	// Drive a real request through the Logging/Recovery/Gzip chain and the
+	// registered handlers before shutting the server down.
+	resp, err := http.Get("http://" + srv.Addr() + "/greet/world")
+	if err != nil {
+		fmt.Println("HTTP request error:", err)
+	} else {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			fmt.Println("HTTP response read error:", readErr)
+		} else {
+			fmt.Print("Response: ", string(body))
+		}
+	}
+
+	cancel() // Trigger the graceful shutdown below
+	if err := <-runDone; err != nil {
+		fmt.Println("HTTP server error:", err)
+		return
+	}
+	fmt.Println("HTTP server shut down cleanly")
+}
+
+// System metrics sampled on a ticker, aggregated behind a RWMutex
+func demonstrateMetrics() {
+	sampler := metrics.NewFakeSampler(
+		metrics.Sample{CPUPercent: 12.50, MemUsedMB: 512, Processes: 84},
+		metrics.Sample{CPUPercent: 47.25, MemUsedMB: 640, Processes: 91},
+		metrics.Sample{CPUPercent: 88.00, MemUsedMB: 701, Processes: 97},
+	)
+	window := metrics.NewWindow(5)
+	samplesCh := make(chan metrics.Sample)
+
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+
+	go func() {
+		defer close(samplesCh)
+		for i := 0; i < 3; i++ {
+			<-ticker.C
+			s, err := sampler.Sample()
+			if err != nil {
+				fmt.Println("sampler error:", err)
+				continue
+			}
+			samplesCh <- s
+		}
+	}()
+
+	for s := range samplesCh {
+		window.Add(s)
+	}
+
+	fmt.Printf("Average CPU over window: %.2f%%\n", window.Average())
+	var sink metrics.MetricSink
+	fmt.Print(sink.Render(window.Snapshot()))
+}
+
+// JWT signing and verification using only stdlib crypto primitives
+func demonstrateJWT() {
+	secret := []byte("super-secret-signing-key")
+	claims := jwtx.Claims{
+		Subject:   "johndoe",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+
+	token, err := claims.Sign(secret)
+	if err != nil {
+		fmt.Println("JWT sign error:", err)
+		return
+	}
+	fmt.Println("Signed token:", token)
+
+	switch decoded, err := jwtx.Verify(token, secret); {
+	case errors.Is(err, jwtx.ErrExpiredToken):
+		fmt.Println("Token expired")
+	case errors.Is(err, jwtx.ErrInvalidSignature):
+		fmt.Println("Token signature invalid")
+	case err != nil:
+		fmt.Println("JWT verify error:", err)
+	default:
+		fmt.Printf("Verified claims: %+v\n", decoded)
+	}
+
+	if _, err := jwtx.Verify(token, []byte("wrong-secret")); errors.Is(err, jwtx.ErrInvalidSignature) {
+		fmt.Println("Tampered secret correctly rejected")
+	}
+}
+
 // Channels - unbuffered
 func demonstrateChannels() {
 	ch := make(chan string)
@@ -437,12 +511,13 @@ func demonstrateWaitGroup() {
 
 // Generics (Go 1.18+)
 func demonstrateGenerics() {
-	// Generic function
+	// Generic function, imported from pkg/mathx
 	intSlice := []int{1, 2, 3, 4, 5}
-	fmt.Println("Sum of ints:", sumGeneric(intSlice))
+	fmt.Println("Sum of ints:", mathx.Sum(intSlice))
 
 	floatSlice := []float64{1.1, 2.2, 3.3}
-	fmt.Println("Sum of floats:", sumGeneric(floatSlice))
+	fmt.Println("Sum of floats:", mathx.Sum(floatSlice))
+	fmt.Printf("Average of floats: %.2f\n", mathx.Average(floatSlice))
 
 	// Generic type
 	intStack := &Stack[int]{}
@@ -458,15 +533,6 @@ func demonstrateGenerics() {
 	fmt.Println("Stack pop:", stringStack.Pop())
 }
 
-// Generic function with type constraint
-func sumGeneric[T int | float64](numbers []T) T {
-	var total T
-	for _, num := range numbers {
-		total += num
-	}
-	return total
-}
-
 // Generic stack type
 type Stack[T any] struct {
 	items []T
@@ -646,7 +712,8 @@ func demonstrateArrayVsSlice() {
 }
 
 func main() {
-	fmt.Println("=== Go Language Demonstration ===\n")
+	fmt.Println("=== Go Language Demonstration ===")
+	fmt.Println()
 
 	// ========================================================================
 	// BASIC FEATURES
@@ -715,14 +782,14 @@ func main() {
 	fmt.Println()
 
 	// ========================================================================
-	// CUSTOM ERROR TYPES
+	// CUSTOM ERROR TYPES (now defined in internal/user)
 	// ========================================================================
 
 	fmt.Println("-- Custom Error Types --")
-	if err := validateAge(-5); err != nil {
+	if err := user.NewPerson("John", -5).Validate(); err != nil {
 		fmt.Println("Validation error:", err)
-		// Type assertion to access custom fields
-		if ve, ok := err.(*ValidationError); ok {
+		// Type assertion to access custom fields across the package boundary
+		if ve, ok := err.(*user.ValidationError); ok {
 			fmt.Printf("Field: %s, Message: %s\n", ve.Field, ve.Message)
 		}
 	}
@@ -787,6 +854,30 @@ func main() {
 	demonstrateJSON()
 	fmt.Println()
 
+	// ========================================================================
+	// HTTP SERVER
+	// ========================================================================
+
+	fmt.Println("-- HTTP Server --")
+	demonstrateHTTP()
+	fmt.Println()
+
+	// ========================================================================
+	// SYSTEM METRICS
+	// ========================================================================
+
+	fmt.Println("-- System Metrics --")
+	demonstrateMetrics()
+	fmt.Println()
+
+	// ========================================================================
+	// JWT SIGNING
+	// ========================================================================
+
+	fmt.Println("-- JWT Signing --")
+	demonstrateJWT()
+	fmt.Println()
+
 	// ========================================================================
 	// CHANNELS
 	// ========================================================================
@@ -863,4 +954,4 @@ func main() {
 	fmt.Println()
 
 	fmt.Println("=== End of Go Demonstration ===")
-}
\ No newline at end of file
+}
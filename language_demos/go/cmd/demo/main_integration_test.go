@@ -0,0 +1,54 @@
+//go:build integration
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ex1tium/cyberdeck-2025_vscode_theme/language_demos/go/pkg/httpx"
+)
+
+// TestDemonstrateHTTPIntegration exercises the real HTTP server end to
+// end: it starts a listener, sends a request through the
+// Logging/Recovery/Gzip middleware chain, and asserts on the response. It's
+// gated behind the integration build tag because it binds a live socket,
+// which is unsuitable for a default `go test ./...` run.
+func TestDemonstrateHTTPIntegration(t *testing.T) {
+	srv, err := httpx.NewServer("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- srv.Run(ctx, 2*time.Second)
+	}()
+
+	resp, err := http.Get("http://" + srv.Addr() + "/greet/world")
+	if err != nil {
+		t.Fatalf("GET /greet/world: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if got, want := string(body), "hello, world!\n"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+
+	cancel()
+	if err := <-runDone; err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{name: "positives", a: 2, b: 3, expected: 5},
+		{name: "negative operand", a: -2, b: 3, expected: 1},
+		{name: "zeros", a: 0, b: 0, expected: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := add(tt.a, tt.b); got != tt.expected {
+				t.Errorf("add(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDivide(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a, b    int
+		want    int
+		wantErr bool
+	}{
+		{name: "evenly divisible", a: 10, b: 2, want: 5},
+		{name: "by zero", a: 10, b: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := divide(tt.a, tt.b)
+			if tt.wantErr {
+				requireError(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("divide(%d, %d) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if got != tt.want {
+				t.Errorf("divide(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// requireError fails the test if err is nil. It takes testing.TB so it
+// also works when called from a benchmark.
+func requireError(tb testing.TB, err error) {
+	tb.Helper()
+	if err == nil {
+		tb.Fatal("expected an error, got nil")
+	}
+}
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		nums []int
+		want int
+	}{
+		{name: "no arguments", nums: nil, want: 0},
+		{name: "several", nums: []int{1, 2, 3, 4, 5}, want: 15},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := sum(tt.nums...); got != tt.want {
+				t.Errorf("sum(%v) = %d, want %d", tt.nums, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		num  int
+		want string
+	}{
+		"negative": {num: -5, want: "negative"},
+		"zero":     {num: 0, want: "zero"},
+		"positive": {num: 5, want: "positive"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := evaluateNumber(tt.num); got != tt.want {
+				t.Errorf("evaluateNumber(%d) = %q, want %q", tt.num, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStack(t *testing.T) {
+	t.Parallel()
+
+	s := &Stack[int]{}
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	for _, want := range []int{3, 2, 1} {
+		if got := s.Pop(); got != want {
+			t.Fatalf("Pop() = %d, want %d", got, want)
+		}
+	}
+
+	if got := s.Pop(); got != 0 {
+		t.Fatalf("Pop() on empty stack = %d, want zero value", got)
+	}
+}
+
+func TestSafeCounter(t *testing.T) {
+	t.Parallel()
+
+	counter := &SafeCounter{}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counter.Increment()
+		}()
+	}
+	wg.Wait()
+
+	if got := counter.Value(); got != 50 {
+		t.Fatalf("Value() = %d, want 50", got)
+	}
+}
+
+func FuzzSum(f *testing.F) {
+	f.Add(1, 2, 3)
+	f.Fuzz(func(t *testing.T, a, b, c int) {
+		if got, want := sum(a, b, c), a+b+c; got != want {
+			t.Errorf("sum(%d, %d, %d) = %d, want %d", a, b, c, got, want)
+		}
+	})
+}
+
+func BenchmarkSum(b *testing.B) {
+	numbers := make([]int, 100)
+	for i := range numbers {
+		numbers[i] = i
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sum(numbers...)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		add(i, i+1)
+	}
+}
+
+func Example_makeCounter() {
+	counter := makeCounter()
+	fmt.Println(counter())
+	fmt.Println(counter())
+	fmt.Println(counter())
+	// Output:
+	// 1
+	// 2
+	// 3
+}
@@ -0,0 +1,44 @@
+// Package user defines account types and their field validation.
+package user
+
+import "fmt"
+
+// Person is a minimal account record with an age that can be validated.
+type Person struct {
+	Name string
+	Age  int
+}
+
+// NewPerson builds a Person from the given fields.
+func NewPerson(name string, age int) Person {
+	return Person{Name: name, Age: age}
+}
+
+// ValidationError reports a single field-level validation failure.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Message)
+}
+
+// Validate checks invariants that aren't expressible via struct tags alone.
+func (p Person) Validate() error {
+	if p.Age < 0 {
+		return &ValidationError{Field: "age", Message: "cannot be negative"}
+	}
+	if p.Age > 150 {
+		return &ValidationError{Field: "age", Message: "unrealistic value"}
+	}
+	return nil
+}
+
+// User models an account record for the JSON marshaling demo.
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+	Password string `json:"-"` // Never serialize
+}
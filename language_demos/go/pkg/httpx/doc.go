@@ -0,0 +1,2 @@
+// Package httpx provides an HTTP server with a small middleware chain.
+package httpx
@@ -0,0 +1,7 @@
+//go:build unix
+
+package httpx
+
+// defaultNetwork is the socket family used when no listener override is
+// supplied. Unix-like platforms listen on raw TCP.
+const defaultNetwork = "tcp"
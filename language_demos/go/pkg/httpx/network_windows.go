@@ -0,0 +1,7 @@
+//go:build windows
+
+package httpx
+
+// defaultNetwork mirrors the unix default. Windows has no SO_REUSEPORT
+// support, but plain TCP needs no special casing either way.
+const defaultNetwork = "tcp"
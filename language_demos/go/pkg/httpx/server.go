@@ -0,0 +1,91 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Server wraps an http.Server with the demo's routes and middleware chain
+// already wired up.
+type Server struct {
+	httpServer *http.Server
+	mux        *http.ServeMux
+	listener   net.Listener
+}
+
+// NewServer binds a listener on addr, using defaultNetwork (chosen per
+// platform by the go:build-tagged files in this package), and builds a
+// Server ready to be passed to Run.
+func NewServer(addr string) (*Server, error) {
+	ln, err := net.Listen(defaultNetwork, addr)
+	if err != nil {
+		return nil, fmt.Errorf("httpx: listen: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	s := &Server{mux: mux, listener: ln}
+	s.httpServer = &http.Server{
+		Handler: Chain(mux, Logging, Recovery, Gzip),
+	}
+
+	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.HandleFunc("/greet/", s.handleGreet)
+	return s, nil
+}
+
+// Addr returns the address the server is listening on, which is useful
+// when addr passed to NewServer used port 0.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+func (s *Server) handleGreet(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/greet/")
+	if name == "" {
+		name = "stranger"
+	}
+
+	// The request context carries the client's cancellation/deadline all
+	// the way down to whatever this handler ends up calling.
+	select {
+	case <-r.Context().Done():
+		http.Error(w, r.Context().Err().Error(), http.StatusRequestTimeout)
+		return
+	default:
+	}
+
+	fmt.Fprintf(w, "hello, %s!\n", name)
+}
+
+// Run serves on the listener bound by NewServer and blocks until ctx is
+// cancelled, at which point it shuts the server down gracefully within
+// shutdownTimeout.
+func (s *Server) Run(ctx context.Context, shutdownTimeout time.Duration) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.httpServer.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+			return
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.httpServer.Shutdown(shutdownCtx)
+}
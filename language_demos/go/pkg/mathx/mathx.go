@@ -0,0 +1,21 @@
+// Package mathx provides small generic numeric helpers.
+package mathx
+
+// Sum adds every element of values, returning the zero value for an empty
+// slice.
+func Sum[T Number](values []T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// Average divides Sum by the element count, returning 0 when values is
+// empty so callers don't need a guard clause.
+func Average[T Number](values []T) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return float64(Sum(values)) / float64(len(values))
+}
@@ -0,0 +1,6 @@
+package mathx
+
+// Number constrains the primitive types our numeric helpers accept.
+type Number interface {
+	~int | ~int32 | ~int64 | ~float32 | ~float64
+}
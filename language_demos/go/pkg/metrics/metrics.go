@@ -0,0 +1,39 @@
+// Package metrics models a small gopsutil-style system sampler, aggregated
+// over a rolling window.
+package metrics
+
+import "fmt"
+
+// Sample is a single point-in-time reading.
+type Sample struct {
+	CPUPercent float64
+	MemUsedMB  uint64
+	Processes  int
+}
+
+// Sampler produces a Sample on demand, mirroring the surface gopsutil
+// exposes via cpu.Percent, mem.VirtualMemory, and process.Pids.
+type Sampler interface {
+	Sample() (Sample, error)
+}
+
+// FakeSampler is an in-memory Sampler so the demo (and tests) don't depend
+// on a real OS process table.
+type FakeSampler struct {
+	samples []Sample
+	next    int
+}
+
+// NewFakeSampler cycles through samples each time Sample is called.
+func NewFakeSampler(samples ...Sample) *FakeSampler {
+	return &FakeSampler{samples: samples}
+}
+
+func (f *FakeSampler) Sample() (Sample, error) {
+	if len(f.samples) == 0 {
+		return Sample{}, fmt.Errorf("fake sampler: no samples configured")
+	}
+	s := f.samples[f.next%len(f.samples)]
+	f.next++
+	return s, nil
+}
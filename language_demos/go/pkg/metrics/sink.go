@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MetricSink renders a crude histogram-like view of CPU usage for the
+// terminal, one '#' per 2% of utilization.
+type MetricSink struct{}
+
+// Render writes a bar-chart line per sample, using fixed-width numeric
+// verbs (%6.2f, %6d) so columns line up.
+func (MetricSink) Render(samples []Sample) string {
+	var b strings.Builder
+	for i, s := range samples {
+		bar := strings.Repeat("#", int(s.CPUPercent/2))
+		fmt.Fprintf(&b, "[%2d] cpu=%6.2f%% mem=%6dMB procs=%4d %s\n", i, s.CPUPercent, s.MemUsedMB, s.Processes, bar)
+	}
+	return b.String()
+}
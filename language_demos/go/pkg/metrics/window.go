@@ -0,0 +1,49 @@
+package metrics
+
+import "sync"
+
+// Window aggregates the most recent samples behind a RWMutex so readers
+// (e.g. a status page) never block a concurrent writer for long.
+type Window struct {
+	mu      sync.RWMutex
+	samples []Sample
+	size    int
+}
+
+// NewWindow returns a Window that retains at most size samples.
+func NewWindow(size int) *Window {
+	return &Window{size: size}
+}
+
+// Add appends a sample, evicting the oldest entry once size is exceeded.
+func (w *Window) Add(s Sample) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples = append(w.samples, s)
+	if len(w.samples) > w.size {
+		w.samples = w.samples[len(w.samples)-w.size:]
+	}
+}
+
+// Average returns the mean CPU percentage across the retained samples.
+func (w *Window) Average() float64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if len(w.samples) == 0 {
+		return 0
+	}
+	var total float64
+	for _, s := range w.samples {
+		total += s.CPUPercent
+	}
+	return total / float64(len(w.samples))
+}
+
+// Snapshot returns a copy of the retained samples.
+func (w *Window) Snapshot() []Sample {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	out := make([]Sample, len(w.samples))
+	copy(out, w.samples)
+	return out
+}